@@ -0,0 +1,86 @@
+package deque
+
+// Bounded wraps a Deque with a fixed maximum length, for use as a sliding
+// window over the most recent N items (log tailing, LRU-ish caches, and
+// the like). Once the deque reaches MaxLen, PushBack discards the front
+// element to make room and PushFront discards the back element.
+type Bounded[T any] struct {
+	*Deque[T]
+	MaxLen int
+}
+
+// MakeBounded creates a Bounded deque with the given maximum length.
+func MakeBounded[T any](maxLen int) *Bounded[T] {
+	return &Bounded[T]{Deque: Make[T](maxLen), MaxLen: maxLen}
+}
+
+// Grow is a no-op once the deque's capacity has reached MaxLen.
+func (b *Bounded[T]) Grow(n int) {
+	if want := b.Len() + n; want > b.MaxLen {
+		n = max(0, b.MaxLen-b.Len())
+	}
+	b.Deque.Grow(n)
+}
+
+// PushBack adds v to the back of the deque, discarding the front element
+// if the deque is already at MaxLen.
+func (b *Bounded[T]) PushBack(v T) {
+	b.PushBackEvict(v)
+}
+
+// PushBackEvict adds v to the back of the deque. If the deque was already
+// at MaxLen, the front element is evicted to make room and returned.
+// If MaxLen <= 0, v itself is returned as immediately evicted.
+func (b *Bounded[T]) PushBackEvict(v T) (evicted T, didEvict bool) {
+	if b.MaxLen <= 0 {
+		return v, true
+	}
+	if b.Len() >= b.MaxLen {
+		evicted, didEvict = b.Deque.RemoveFront()
+	}
+	b.Deque.PushBack(v)
+	return
+}
+
+// PushFront adds v to the front of the deque, discarding the back element
+// if the deque is already at MaxLen. If MaxLen <= 0, v is discarded instead.
+func (b *Bounded[T]) PushFront(v T) {
+	if b.MaxLen <= 0 {
+		return
+	}
+	if b.Len() >= b.MaxLen {
+		b.Deque.RemoveBack()
+	}
+	b.Deque.PushFront(v)
+}
+
+// PushBackSlice adds all items in s to the back of the deque, evicting from
+// the front as needed. If s is longer than MaxLen, only the last MaxLen
+// items of s are kept.
+func (b *Bounded[T]) PushBackSlice(s []T) {
+	if b.MaxLen <= 0 {
+		return
+	}
+	if len(s) > b.MaxLen {
+		s = s[len(s)-b.MaxLen:]
+	}
+	for _, v := range s {
+		b.PushBackEvict(v)
+	}
+}
+
+// PushFrontSlice adds all items in s to the front of the deque, evicting
+// from the back as needed, preserving their order. If s is longer than
+// MaxLen, only the first MaxLen items of s are kept.
+func (b *Bounded[T]) PushFrontSlice(s []T) {
+	if b.MaxLen <= 0 {
+		return
+	}
+	if len(s) > b.MaxLen {
+		s = s[:b.MaxLen]
+	}
+	b.Grow(len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		b.PushFront(s[i])
+	}
+}
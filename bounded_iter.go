@@ -0,0 +1,22 @@
+//go:build go1.23
+
+package deque
+
+import (
+	"iter"
+	"slices"
+)
+
+// PushBackSeq adds all items in seq to the back of the deque, evicting from
+// the front as needed so the deque never grows past MaxLen.
+func (b *Bounded[T]) PushBackSeq(seq iter.Seq[T]) {
+	for v := range seq {
+		b.PushBackEvict(v)
+	}
+}
+
+// PushFrontSeq adds all items in seq to the front of the deque, evicting
+// from the back as needed, preserving the order in which seq yields them.
+func (b *Bounded[T]) PushFrontSeq(seq iter.Seq[T]) {
+	b.PushFrontSlice(slices.Collect(seq))
+}
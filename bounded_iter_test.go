@@ -0,0 +1,35 @@
+//go:build go1.23
+
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+func TestBoundedPushBackSeq(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	b.PushBackSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+	want := []int{3, 4, 5}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
+
+func TestBoundedPushFrontSeq(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	b.PushFrontSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+	if b.Len() != 3 {
+		t.Fatalf("Len() = %d; want 3", b.Len())
+	}
+	want := []int{1, 2, 3}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package deque_test
+
+import (
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+func TestBoundedPushBack(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	for i := 1; i <= 5; i++ {
+		b.PushBack(i)
+	}
+	if b.Len() != 3 {
+		t.Fatalf("Len() = %d; want 3", b.Len())
+	}
+	want := []int{3, 4, 5}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
+
+func TestBoundedZeroMaxLen(t *testing.T) {
+	b := deque.MakeBounded[int](0)
+	for i := 0; i < 5; i++ {
+		b.PushBack(i)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("Len() = %d; want 0", b.Len())
+	}
+	for i := 0; i < 5; i++ {
+		b.PushFront(i)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("Len() = %d; want 0", b.Len())
+	}
+	if evicted, ok := b.PushBackEvict(9); !ok || evicted != 9 {
+		t.Errorf("PushBackEvict(9) = %d, %v; want 9, true", evicted, ok)
+	}
+}
+
+func TestBoundedPushBackEvict(t *testing.T) {
+	b := deque.MakeBounded[int](2)
+	b.PushBack(1)
+	b.PushBack(2)
+	evicted, ok := b.PushBackEvict(3)
+	if !ok || evicted != 1 {
+		t.Errorf("PushBackEvict(3) = %d, %v; want 1, true", evicted, ok)
+	}
+	if v, _ := b.At(0); v != 2 {
+		t.Errorf("At(0) = %d; want 2", v)
+	}
+}
+
+func TestBoundedPushFront(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	for i := 1; i <= 5; i++ {
+		b.PushFront(i)
+	}
+	want := []int{5, 4, 3}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
+
+func TestBoundedPushBackSlice(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	b.PushBackSlice([]int{1, 2, 3, 4, 5})
+	want := []int{3, 4, 5}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
+
+func TestBoundedPushFrontSlice(t *testing.T) {
+	b := deque.MakeBounded[int](3)
+	b.PushFrontSlice([]int{1, 2, 3, 4, 5})
+	if b.Len() != 3 {
+		t.Fatalf("Len() = %d; want 3", b.Len())
+	}
+	want := []int{1, 2, 3}
+	for i, w := range want {
+		if v, _ := b.At(i); v != w {
+			t.Errorf("At(%d) = %d; want %d", i, v, w)
+		}
+	}
+}
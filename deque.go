@@ -6,13 +6,23 @@ package deque
 import (
 	"cmp"
 	"fmt"
+	"math/bits"
+	"sort"
 	"strings"
 )
 
 // Deque is a double-ended queue. It is not concurrency safe.
 type Deque[T any] struct {
-	len, head int
-	backing   []T
+	len, head, mask int
+	backing         []T
+}
+
+// nextPow2 returns the smallest power of two greater than or equal to n.
+func nextPow2(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(n-1))
 }
 
 // Make creates a deque with a prereserved capacity.
@@ -42,10 +52,9 @@ func (d *Deque[T]) Grow(n int) {
 	if d.Cap()-d.len >= n {
 		return
 	}
-	// using append to get amortized growth
-	grown := append(d.backing, make([]T, n)...)
-	grown = grown[:cap(grown)]
-	d.copy(grown)
+	// rounding up to a power of two gives amortized doubling for free
+	// and lets At/PushFront/RemoveFront index with a bitmask instead of %.
+	d.copy(make([]T, nextPow2(d.len+n)))
 }
 
 // Len returns the current length of the deque.
@@ -54,6 +63,8 @@ func (d *Deque[T]) Len() int {
 }
 
 // Cap returns the total current capacity of the deque.
+// The backing array is always either empty or sized to a power of two,
+// so Cap reports the raw length of the backing slice rather than a logical reservation.
 func (d *Deque[T]) Cap() int {
 	return len(d.backing)
 }
@@ -62,10 +73,7 @@ func (d *Deque[T]) Cap() int {
 func (d *Deque[T]) PushFront(v T) {
 	d.Grow(1)
 	d.len++
-	d.head--
-	if d.head < 0 {
-		d.head = d.Cap() - 1
-	}
+	d.head = (d.head - 1) & d.mask
 	d.backing[d.head] = v
 }
 
@@ -75,14 +83,21 @@ func (d *Deque[T]) copy(dst []T) {
 	copy(dst[n:], back)
 	d.head = 0
 	d.backing = dst
+	d.mask = len(dst) - 1
 }
 
-// Clip removes unused capacity from the deque.
+// Clip removes unused capacity from the deque, shrinking the backing array
+// to the smallest power of two that still fits Len items.
+// An empty deque releases its backing array entirely, leaving Cap at 0.
 func (d *Deque[T]) Clip() {
-	if d.Cap() == d.Len() {
+	target := 0
+	if d.Len() > 0 {
+		target = nextPow2(d.Len())
+	}
+	if d.Cap() == target {
 		return
 	}
-	d.copy(make([]T, d.Len()))
+	d.copy(make([]T, target))
 }
 
 // Front returns the first value of the deque,
@@ -102,7 +117,7 @@ func (d *Deque[T]) at(n int) *T {
 	if n < 0 || n > d.len-1 {
 		return nil
 	}
-	return &d.backing[(d.head+n)%d.Cap()]
+	return &d.backing[(d.head+n)&d.mask]
 }
 
 // At returns the zero indexed nth item of the deque, if any.
@@ -145,10 +160,7 @@ func (d *Deque[T]) RemoveFront() (t T, ok bool) {
 		return
 	}
 	head, _ := d.Front()
-	d.head++
-	if d.head >= d.Cap() {
-		d.head = 0
-	}
+	d.head = (d.head + 1) & d.mask
 	d.len--
 	return head, true
 }
@@ -164,6 +176,129 @@ func (d *Deque[T]) RemoveBack() (t T, ok bool) {
 	return tail, true
 }
 
+// Insert inserts the values v at index i, moving existing elements aside to make room.
+// It shifts whichever side of i is shorter, so the cost is O(min(i, Len()-i) + len(v)).
+// It panics if i is out of range.
+func (d *Deque[T]) Insert(i int, v ...T) {
+	if i < 0 || i > d.len {
+		panic("index out of range")
+	}
+	n := len(v)
+	if n == 0 {
+		return
+	}
+	d.Grow(n)
+	if i <= d.len-i {
+		var zero T
+		for k := 0; k < n; k++ {
+			d.PushFront(zero)
+		}
+		for k := 0; k < i; k++ {
+			x, _ := d.At(n + k)
+			*d.at(k) = x
+		}
+	} else {
+		var zero T
+		for k := 0; k < n; k++ {
+			d.PushBack(zero)
+		}
+		for k := d.len - n - 1; k >= i; k-- {
+			x, _ := d.At(k)
+			*d.at(k + n) = x
+		}
+	}
+	for k, x := range v {
+		*d.at(i + k) = x
+	}
+}
+
+// Delete removes and returns the element at index i, if any.
+// It shifts whichever side of i is shorter, so the cost is O(min(i, Len()-i)).
+func (d *Deque[T]) Delete(i int) (t T, ok bool) {
+	if i < 0 || i >= d.len {
+		return
+	}
+	v, _ := d.At(i)
+	if i <= d.len-1-i {
+		for k := i; k > 0; k-- {
+			x, _ := d.At(k - 1)
+			*d.at(k) = x
+		}
+		d.RemoveFront()
+	} else {
+		for k := i; k < d.len-1; k++ {
+			x, _ := d.At(k + 1)
+			*d.at(k) = x
+		}
+		d.RemoveBack()
+	}
+	return v, true
+}
+
+// DeleteRange removes the elements with indexes in the range [i, j) from the deque.
+// It shifts whichever side of the range is shorter, so the cost is O(min(i, Len()-j)).
+// It panics if the range is out of bounds.
+func (d *Deque[T]) DeleteRange(i, j int) {
+	if i < 0 || j > d.len || i > j {
+		panic("index out of range")
+	}
+	n := j - i
+	if n == 0 {
+		return
+	}
+	if i <= d.len-j {
+		for k := i - 1; k >= 0; k-- {
+			x, _ := d.At(k)
+			*d.at(k + n) = x
+		}
+		for k := 0; k < n; k++ {
+			d.RemoveFront()
+		}
+	} else {
+		for k := j; k < d.len; k++ {
+			x, _ := d.At(k)
+			*d.at(k - n) = x
+		}
+		for k := 0; k < n; k++ {
+			d.RemoveBack()
+		}
+	}
+}
+
+// Rotate rotates the deque by n elements: positive n moves elements from the
+// back to the front, negative n moves elements from the front to the back.
+// Each step is an O(1) head or tail adjustment, so after reducing n modulo
+// Len(), Rotate costs O(min(n, Len()-n)).
+func (d *Deque[T]) Rotate(n int) {
+	if d.len == 0 {
+		return
+	}
+	n %= d.len
+	if n < 0 {
+		n += d.len
+	}
+	if n > d.len-n {
+		n -= d.len
+	}
+	for ; n > 0; n-- {
+		v, _ := d.RemoveBack()
+		d.PushFront(v)
+	}
+	for ; n < 0; n++ {
+		v, _ := d.RemoveFront()
+		d.PushBack(v)
+	}
+}
+
+// Clear removes all elements from the deque, zeroing the backing storage
+// they occupied so that pointer-typed elements can be garbage collected.
+func (d *Deque[T]) Clear() {
+	front, back := d.frontback()
+	clear(front)
+	clear(back)
+	d.len = 0
+}
+
 func (d *Deque[T]) frontback() (front, back []T) {
 	end := min(d.head+d.len, len(d.backing))
 	front = d.backing[d.head:end]
@@ -217,3 +352,83 @@ func (sd Sortable[T]) Less(i, j int) bool {
 	}
 	return *sd.at(i) < *sd.at(j)
 }
+
+// sortFuncAdapter adapts a Deque and a comparison function to sort.Interface.
+type sortFuncAdapter[T any] struct {
+	*Deque[T]
+	cmp func(a, b T) int
+}
+
+// Less implements sort.Interface.
+func (sd sortFuncAdapter[T]) Less(i, j int) bool {
+	if i > sd.len {
+		panic("i out of bounds")
+	}
+	if j > sd.len {
+		panic("j out of bounds")
+	}
+	return sd.cmp(*sd.at(i), *sd.at(j)) < 0
+}
+
+// SortFunc sorts the deque in place using cmp to compare elements,
+// without requiring T to satisfy cmp.Ordered.
+func SortFunc[T any](d *Deque[T], cmp func(a, b T) int) {
+	sort.Sort(sortFuncAdapter[T]{d, cmp})
+}
+
+// SortStableFunc sorts the deque in place using cmp to compare elements,
+// keeping equal elements in their original order.
+func SortStableFunc[T any](d *Deque[T], cmp func(a, b T) int) {
+	sort.Stable(sortFuncAdapter[T]{d, cmp})
+}
+
+// BinarySearchFunc searches for target in a deque sorted in ascending order,
+// as determined by cmp. It returns the smallest index at which cmp(v, target) >= 0,
+// and whether target was found at that index.
+func BinarySearchFunc[T, E any](d *Deque[T], target E, cmp func(a T, b E) int) (int, bool) {
+	i, j := 0, d.Len()
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		v, _ := d.At(h)
+		if cmp(v, target) < 0 {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	found := i < d.Len()
+	if found {
+		v, _ := d.At(i)
+		found = cmp(v, target) == 0
+	}
+	return i, found
+}
+
+// Compact replaces consecutive runs of equal elements with a single copy,
+// shrinking the deque's length, mirroring slices.Compact.
+func Compact[T comparable](d *Deque[T]) {
+	CompactFunc(d, func(a, b T) bool { return a == b })
+}
+
+// CompactFunc replaces consecutive runs of elements for which eq returns true
+// with the first element of each run, shrinking the deque's length,
+// mirroring slices.CompactFunc.
+func CompactFunc[T any](d *Deque[T], eq func(a, b T) bool) {
+	if d.len < 2 {
+		return
+	}
+	w := 1
+	prev, _ := d.At(0)
+	for r := 1; r < d.len; r++ {
+		v, _ := d.At(r)
+		if eq(prev, v) {
+			continue
+		}
+		*d.at(w) = v
+		prev = v
+		w++
+	}
+	for d.len > w {
+		d.RemoveBack()
+	}
+}
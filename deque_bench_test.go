@@ -0,0 +1,49 @@
+// Package deque_test benchmarks the hot paths that the power-of-two/bitmask
+// indexing change targets (At, PushFront, PushBack, RemoveBack). To see the
+// before/after win, run these with benchstat against the commit that
+// introduced the bitmask indexing, e.g.:
+//
+//	git stash; go test -run=^$ -bench=. -count=10 > old.txt
+//	git stash pop; go test -run=^$ -bench=. -count=10 > new.txt
+//	benchstat old.txt new.txt
+package deque_test
+
+import (
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+func BenchmarkPushBack(b *testing.B) {
+	d := deque.Make[int](0)
+	for i := 0; i < b.N; i++ {
+		d.PushBack(i)
+	}
+}
+
+func BenchmarkPushFront(b *testing.B) {
+	d := deque.Make[int](0)
+	for i := 0; i < b.N; i++ {
+		d.PushFront(i)
+	}
+}
+
+func BenchmarkAt(b *testing.B) {
+	const n = 1 << 10
+	d := deque.Make[int](n)
+	for i := 0; i < n; i++ {
+		d.PushBack(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.At(i % n)
+	}
+}
+
+func BenchmarkPushFrontPopBack(b *testing.B) {
+	d := deque.Make[int](1 << 10)
+	for i := 0; i < b.N; i++ {
+		d.PushFront(i)
+		d.RemoveBack()
+	}
+}
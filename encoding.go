@@ -0,0 +1,144 @@
+package deque
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// toSlice copies the deque's elements, front to back, into a new slice.
+func (d *Deque[T]) toSlice() []T {
+	s := make([]T, 0, d.Len())
+	front, back := d.frontback()
+	s = append(s, front...)
+	s = append(s, back...)
+	return s
+}
+
+// MarshalJSON implements json.Marshaler. It serialises the deque as a plain
+// array in front-to-back order.
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	i := 0
+	front, back := d.frontback()
+	for _, slice := range [][]T{front, back} {
+		for _, item := range slice {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			b, err := json.Marshal(item)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(b)
+			i++
+		}
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	var s []T
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	d.Clear()
+	d.Grow(len(s))
+	d.PushBackSlice(s)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. When T has a fixed size
+// under encoding/binary (fixed-size numbers, bools, and arrays or structs of
+// them), it uses a compact length-prefixed encoding via encoding/binary;
+// otherwise it falls back to gob, which requires T itself to be encodable
+// with gob.
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	var zero T
+	if binary.Size(zero) > 0 {
+		return d.marshalBinaryFixed()
+	}
+	return d.marshalBinaryGob()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	var zero T
+	if binary.Size(zero) > 0 {
+		return d.unmarshalBinaryFixed(data)
+	}
+	return d.unmarshalBinaryGob(data)
+}
+
+func (d *Deque[T]) marshalBinaryFixed() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.BigEndian, int64(d.Len())); err != nil {
+		return nil, err
+	}
+	front, back := d.frontback()
+	if err := binary.Write(&buf, binary.BigEndian, front); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(&buf, binary.BigEndian, back); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *Deque[T]) unmarshalBinaryFixed(data []byte) error {
+	r := bytes.NewReader(data)
+	var n int64
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return err
+	}
+	if n < 0 {
+		return fmt.Errorf("deque: invalid element count %d", n)
+	}
+	var zero T
+	elemSize := int64(binary.Size(zero))
+	if n > int64(r.Len())/elemSize {
+		return fmt.Errorf("deque: element count %d exceeds available data", n)
+	}
+	s := make([]T, n)
+	if err := binary.Read(r, binary.BigEndian, s); err != nil {
+		return err
+	}
+	d.Clear()
+	d.Grow(len(s))
+	d.PushBackSlice(s)
+	return nil
+}
+
+func (d *Deque[T]) marshalBinaryGob() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(d.toSlice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (d *Deque[T]) unmarshalBinaryGob(data []byte) error {
+	var s []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return err
+	}
+	d.Clear()
+	d.Grow(len(s))
+	d.PushBackSlice(s)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+func (d *Deque[T]) GobEncode() ([]byte, error) {
+	return d.marshalBinaryGob()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (d *Deque[T]) GobDecode(data []byte) error {
+	return d.unmarshalBinaryGob(data)
+}
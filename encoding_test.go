@@ -0,0 +1,96 @@
+package deque_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	d := deque.Of(1, 2, 3, 4, 5)
+	d.PushFront(0)
+
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "[0,1,2,3,4,5]"; string(data) != want {
+		t.Errorf("json.Marshal() = %s; want %s", data, want)
+	}
+
+	var got deque.Deque[int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != d.String() {
+		t.Errorf("round trip = %s; want %s", got.String(), d.String())
+	}
+}
+
+func TestBinaryRoundTripFixed(t *testing.T) {
+	d := deque.Of[int32](10, 20, 30)
+	d.PushFront(0)
+
+	data, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got deque.Deque[int32]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != d.String() {
+		t.Errorf("round trip = %s; want %s", got.String(), d.String())
+	}
+}
+
+func TestUnmarshalBinaryFixedRejectsBadLength(t *testing.T) {
+	// A length prefix of math.MaxInt64 with no element data behind it.
+	data := []byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	var got deque.Deque[int32]
+	if err := got.UnmarshalBinary(data); err == nil {
+		t.Fatal("UnmarshalBinary with an oversized length prefix should return an error, not panic")
+	}
+}
+
+func TestBinaryRoundTripGobFallback(t *testing.T) {
+	d := deque.Of(point{1, 2}, point{3, 4})
+
+	data, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got deque.Deque[point]
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != d.String() {
+		t.Errorf("round trip = %s; want %s", got.String(), d.String())
+	}
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	d := deque.Of(point{1, 2}, point{3, 4}, point{5, 6})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(d); err != nil {
+		t.Fatal(err)
+	}
+
+	var got deque.Deque[point]
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.String() != d.String() {
+		t.Errorf("round trip = %s; want %s", got.String(), d.String())
+	}
+}
@@ -3,6 +3,7 @@
 package deque
 
 import (
+	"cmp"
 	"iter"
 	"slices"
 )
@@ -28,6 +29,18 @@ func (d *Deque[T]) All() iter.Seq2[int, T] {
 	}
 }
 
+// Values returns a sequence yielding each value in the deque.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.Len() {
+			v, ok := d.At(i)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Reverse returns a sequence yielding each index and value in the deque in reverse order.
 func (d *Deque[T]) Reverse() iter.Seq2[int, T] {
 	return func(yield func(int, T) bool) {
@@ -40,6 +53,12 @@ func (d *Deque[T]) Reverse() iter.Seq2[int, T] {
 	}
 }
 
+// Backward returns a sequence yielding each index and value in the deque in reverse order.
+// It is an alias for Reverse matching the naming used by the slices package.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return d.Reverse()
+}
+
 // PushBackSeq adds all items in seq to the back of the deque.
 func (d *Deque[T]) PushBackSeq(seq iter.Seq[T]) {
 	for v := range seq {
@@ -47,8 +66,41 @@ func (d *Deque[T]) PushBackSeq(seq iter.Seq[T]) {
 	}
 }
 
-// PushBackSlice adds all items in s to the back of the deque.
-func (d *Deque[T]) PushBackSlice(s []T) {
+// PushFrontSeq adds all items in seq to the front of the deque,
+// preserving the order in which seq yields them.
+func (d *Deque[T]) PushFrontSeq(seq iter.Seq[T]) {
+	d.PushFrontSlice(slices.Collect(seq))
+}
+
+// PushFrontSlice adds all items in s to the front of the deque,
+// preserving the order they appear in s.
+func (d *Deque[T]) PushFrontSlice(s []T) {
 	d.Grow(len(s))
-	d.PushBackSeq(slices.Values(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		d.PushFront(s[i])
+	}
+}
+
+// Collect collects values from seq into a new Deque.
+func Collect[T any](seq iter.Seq[T]) *Deque[T] {
+	d := new(Deque[T])
+	d.PushBackSeq(seq)
+	return d
+}
+
+// AppendSeq appends values from seq to the back of d and returns d,
+// mirroring slices.AppendSeq.
+func AppendSeq[T any](d *Deque[T], seq iter.Seq[T]) *Deque[T] {
+	d.PushBackSeq(seq)
+	return d
+}
+
+// Sorted collects values from seq into a new Deque sorted in ascending order.
+func Sorted[T cmp.Ordered](seq iter.Seq[T]) *Deque[T] {
+	return Of(slices.Sorted(seq)...)
+}
+
+// SortedFunc collects values from seq into a new Deque sorted using cmp to compare elements.
+func SortedFunc[T any](seq iter.Seq[T], cmp func(a, b T) int) *Deque[T] {
+	return Of(slices.SortedFunc(seq, cmp)...)
 }
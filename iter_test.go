@@ -0,0 +1,79 @@
+//go:build go1.23
+
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+func TestValues(t *testing.T) {
+	d := deque.Of(1, 2, 3)
+	got := slices.Collect(d.Values())
+	want := []int{1, 2, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Values() = %v; want %v", got, want)
+	}
+}
+
+func TestBackward(t *testing.T) {
+	d := deque.Of(1, 2, 3)
+	var got []int
+	for _, v := range d.Backward() {
+		got = append(got, v)
+	}
+	want := []int{3, 2, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("Backward() = %v; want %v", got, want)
+	}
+}
+
+func TestPushFrontSeq(t *testing.T) {
+	d := deque.Of(4, 5, 6)
+	d.PushFrontSeq(slices.Values([]int{1, 2, 3}))
+	got := d.Slice()
+	want := []int{1, 2, 3, 4, 5, 6}
+	if !slices.Equal(got, want) {
+		t.Errorf("PushFrontSeq() = %v; want %v", got, want)
+	}
+}
+
+func TestPushFrontSlice(t *testing.T) {
+	d := deque.Of(4, 5, 6)
+	d.PushFrontSlice([]int{1, 2, 3})
+	got := d.Slice()
+	want := []int{1, 2, 3, 4, 5, 6}
+	if !slices.Equal(got, want) {
+		t.Errorf("PushFrontSlice() = %v; want %v", got, want)
+	}
+}
+
+func TestCollectAppendSeq(t *testing.T) {
+	d := deque.Collect(slices.Values([]int{1, 2, 3}))
+	deque.AppendSeq(d, slices.Values([]int{4, 5}))
+	got := d.Slice()
+	want := []int{1, 2, 3, 4, 5}
+	if !slices.Equal(got, want) {
+		t.Errorf("Collect/AppendSeq = %v; want %v", got, want)
+	}
+}
+
+func TestSorted(t *testing.T) {
+	d := deque.Sorted(slices.Values([]int{3, 1, 2}))
+	got := d.Slice()
+	want := []int{1, 2, 3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Sorted() = %v; want %v", got, want)
+	}
+}
+
+func TestSortedFunc(t *testing.T) {
+	d := deque.SortedFunc(slices.Values([]int{3, 1, 2}), func(a, b int) int { return b - a })
+	got := d.Slice()
+	want := []int{3, 2, 1}
+	if !slices.Equal(got, want) {
+		t.Errorf("SortedFunc() = %v; want %v", got, want)
+	}
+}
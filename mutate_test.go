@@ -0,0 +1,124 @@
+package deque_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+func deqSlice(d *deque.Deque[int]) []int {
+	s := make([]int, d.Len())
+	for i := range s {
+		s[i], _ = d.At(i)
+	}
+	return s
+}
+
+func TestInsert(t *testing.T) {
+	cases := []struct {
+		start []int
+		i     int
+		v     []int
+		want  []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []int{8, 9}, []int{1, 2, 8, 9, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 0, []int{8, 9}, []int{8, 9, 1, 2, 3, 4, 5}},
+		{[]int{1, 2, 3, 4, 5}, 5, []int{8, 9}, []int{1, 2, 3, 4, 5, 8, 9}},
+		{[]int{}, 0, []int{8}, []int{8}},
+	}
+	for _, c := range cases {
+		d := deque.Of(c.start...)
+		d.Insert(c.i, c.v...)
+		if got := deqSlice(d); !slices.Equal(got, c.want) {
+			t.Errorf("Insert(%d, %v) on %v = %v; want %v", c.i, c.v, c.start, got, c.want)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := []struct {
+		start []int
+		i     int
+		want  []int
+		wantV int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 1, []int{1, 3, 4, 5}, 2},
+		{[]int{1, 2, 3, 4, 5}, 0, []int{2, 3, 4, 5}, 1},
+		{[]int{1, 2, 3, 4, 5}, 3, []int{1, 2, 3, 5}, 4},
+	}
+	for _, c := range cases {
+		d := deque.Of(c.start...)
+		v, ok := d.Delete(c.i)
+		if !ok || v != c.wantV {
+			t.Errorf("Delete(%d) on %v = %v, %v; want %v, true", c.i, c.start, v, ok, c.wantV)
+		}
+		if got := deqSlice(d); !slices.Equal(got, c.want) {
+			t.Errorf("Delete(%d) on %v left %v; want %v", c.i, c.start, got, c.want)
+		}
+	}
+	d := deque.Of(1)
+	if _, ok := d.Delete(5); ok {
+		t.Error("Delete out of range should report ok=false")
+	}
+}
+
+func TestDeleteRange(t *testing.T) {
+	cases := []struct {
+		start []int
+		i, j  int
+		want  []int
+	}{
+		{[]int{1, 2, 3, 4, 5, 6}, 1, 3, []int{1, 4, 5, 6}},
+		{[]int{1, 2, 3, 4, 5, 6}, 3, 5, []int{1, 2, 3, 6}},
+		{[]int{1, 2, 3, 4, 5, 6}, 0, 6, []int{}},
+	}
+	for _, c := range cases {
+		d := deque.Of(c.start...)
+		d.DeleteRange(c.i, c.j)
+		if got := deqSlice(d); !slices.Equal(got, c.want) {
+			t.Errorf("DeleteRange(%d, %d) on %v = %v; want %v", c.i, c.j, c.start, got, c.want)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	cases := []struct {
+		n    int
+		want []int
+	}{
+		{1, []int{5, 1, 2, 3, 4}},
+		{2, []int{4, 5, 1, 2, 3}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{0, []int{1, 2, 3, 4, 5}},
+	}
+	for _, c := range cases {
+		d := deque.Of(1, 2, 3, 4, 5)
+		d.Rotate(c.n)
+		if got := deqSlice(d); !slices.Equal(got, c.want) {
+			t.Errorf("Rotate(%d) = %v; want %v", c.n, got, c.want)
+		}
+	}
+}
+
+func TestClear(t *testing.T) {
+	d := deque.Of(1, 2, 3)
+	d.Clear()
+	if d.Len() != 0 {
+		t.Errorf("Clear() left Len() = %d; want 0", d.Len())
+	}
+	d.PushBack(9)
+	if got, _ := d.At(0); got != 9 {
+		t.Errorf("After Clear, PushBack(9); At(0) = %d; want 9", got)
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	d := deque.Of(1, 1, 2, 2, 2, 3, 1)
+	deque.CompactFunc(d, func(a, b int) bool { return a == b })
+	want := []int{1, 2, 3, 1}
+	if got := deqSlice(d); !slices.Equal(got, want) {
+		t.Errorf("CompactFunc() = %v; want %v", got, want)
+	}
+}
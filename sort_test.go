@@ -0,0 +1,59 @@
+package deque_test
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/earthboundkid/deque/v2"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestSortFunc(t *testing.T) {
+	d := deque.Of(
+		person{"carol", 30},
+		person{"alice", 25},
+		person{"bob", 35},
+	)
+	deque.SortFunc(d, func(a, b person) int { return cmp.Compare(a.age, b.age) })
+	want := []string{"alice", "carol", "bob"}
+	for i, name := range want {
+		p, ok := d.At(i)
+		if !ok || p.name != name {
+			t.Errorf("At(%d) = %v; want name %s", i, p, name)
+		}
+	}
+}
+
+func TestSortStableFunc(t *testing.T) {
+	d := deque.Of(
+		person{"a", 1},
+		person{"b", 1},
+		person{"c", 0},
+	)
+	deque.SortStableFunc(d, func(a, b person) int { return cmp.Compare(a.age, b.age) })
+	want := []string{"c", "a", "b"}
+	for i, name := range want {
+		p, ok := d.At(i)
+		if !ok || p.name != name {
+			t.Errorf("At(%d) = %v; want name %s", i, p, name)
+		}
+	}
+}
+
+func TestBinarySearchFunc(t *testing.T) {
+	d := deque.Of(1, 3, 5, 7, 9)
+	cmp := func(a, target int) int { return a - target }
+	if i, ok := deque.BinarySearchFunc(d, 5, cmp); !ok || i != 2 {
+		t.Errorf("BinarySearchFunc(5) = %d, %v; want 2, true", i, ok)
+	}
+	if i, ok := deque.BinarySearchFunc(d, 4, cmp); ok || i != 2 {
+		t.Errorf("BinarySearchFunc(4) = %d, %v; want 2, false", i, ok)
+	}
+	if i, ok := deque.BinarySearchFunc(d, 10, cmp); ok || i != 5 {
+		t.Errorf("BinarySearchFunc(10) = %d, %v; want 5, false", i, ok)
+	}
+}